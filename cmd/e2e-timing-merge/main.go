@@ -0,0 +1,116 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command e2e-timing-merge combines the per-suite JSON timing reports
+// produced by framework.TestTimer.WriteJSON (KTHENA_E2E_JSON_OUT) into a
+// single report, sorted by total suite duration, so slow suites across the
+// whole e2e matrix are visible in one place.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/volcano-sh/kthena/test/e2e/framework"
+)
+
+// suiteSummary is the merged, per-suite total across every input report.
+type suiteSummary struct {
+	Suite         string        `json:"suite"`
+	TestCount     int           `json:"test_count"`
+	TotalDuration time.Duration `json:"total_duration_ns"`
+}
+
+func main() {
+	out := flag.String("out", "", "path to write the merged JSON report (default: stdout)")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: e2e-timing-merge [-out report.json] <timing.json>...")
+		os.Exit(1)
+	}
+
+	summaries, err := merge(flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	report, err := json.MarshalIndent(summaries, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "marshal report: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *out == "" {
+		fmt.Println(string(report))
+		return
+	}
+	if err := os.WriteFile(*out, report, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "writing %s: %v\n", *out, err)
+		os.Exit(1)
+	}
+}
+
+// merge reads every path as a JSON array of framework.TimingRecord and
+// returns per-suite totals sorted by TotalDuration, longest first.
+func merge(paths []string) ([]suiteSummary, error) {
+	totals := make(map[string]*suiteSummary)
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		var records []framework.TimingRecord
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+
+		for _, r := range records {
+			s, ok := totals[r.Suite]
+			if !ok {
+				s = &suiteSummary{Suite: r.Suite}
+				totals[r.Suite] = s
+			}
+			// "phase" records are nested spans inside an enclosing "test"
+			// record's time window (see framework.TestTimer.StartPhase), so
+			// including them here would double-count that time.
+			if r.Phase == "phase" {
+				continue
+			}
+			s.TotalDuration += time.Duration(r.DurationNS)
+			if r.Phase == "test" {
+				s.TestCount++
+			}
+		}
+	}
+
+	summaries := make([]suiteSummary, 0, len(totals))
+	for _, s := range totals {
+		summaries = append(summaries, *s)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].TotalDuration > summaries[j].TotalDuration
+	})
+
+	return summaries, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/volcano-sh/kthena/test/e2e/framework"
+)
+
+func writeRecords(t *testing.T, records []framework.TimingRecord) string {
+	t.Helper()
+	data, err := json.Marshal(records)
+	if err != nil {
+		t.Fatalf("marshal records: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "timing.json")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+	return path
+}
+
+func TestMergeCountsOnlyTestPhaseRecords(t *testing.T) {
+	path := writeRecords(t, []framework.TimingRecord{
+		{Suite: "router", Phase: "setup", Name: "install-crds", DurationNS: 1_000_000_000},
+		{Suite: "router", Phase: "test", Name: "TestFoo", DurationNS: 2_000_000_000},
+		{Suite: "router", Phase: "cleanup", Name: "delete-ns", DurationNS: 500_000_000},
+	})
+
+	summaries, err := merge([]string{path})
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if len(summaries) != 1 {
+		t.Fatalf("expected 1 suite summary, got %d", len(summaries))
+	}
+	if summaries[0].TestCount != 1 {
+		t.Fatalf("expected TestCount=1, got %d", summaries[0].TestCount)
+	}
+}
+
+func TestMergeExcludesNestedPhaseDurations(t *testing.T) {
+	// "phase" records are nested spans inside the enclosing "test" record's
+	// time window, so TotalDuration must not count them a second time.
+	path := writeRecords(t, []framework.TimingRecord{
+		{Suite: "router", Phase: "test", Name: "TestFoo", DurationNS: 10_000_000_000},
+		{Suite: "router", Phase: "phase", Name: "wait-for-ready", DurationNS: 6_000_000_000},
+	})
+
+	summaries, err := merge([]string{path})
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if len(summaries) != 1 {
+		t.Fatalf("expected 1 suite summary, got %d", len(summaries))
+	}
+	if summaries[0].TotalDuration.Seconds() != 10 {
+		t.Fatalf("expected TotalDuration=10s (phase span excluded), got %v", summaries[0].TotalDuration)
+	}
+}
+
+func TestMergeSortsByTotalDurationDescending(t *testing.T) {
+	fast := writeRecords(t, []framework.TimingRecord{
+		{Suite: "fast", Phase: "test", Name: "TestFast", DurationNS: 1_000_000_000},
+	})
+	slow := writeRecords(t, []framework.TimingRecord{
+		{Suite: "slow", Phase: "test", Name: "TestSlow", DurationNS: 5_000_000_000},
+	})
+
+	summaries, err := merge([]string{fast, slow})
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if len(summaries) != 2 || summaries[0].Suite != "slow" || summaries[1].Suite != "fast" {
+		t.Fatalf("expected [slow, fast] order, got %v", summaries)
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	clientset "github.com/volcano-sh/kthena/client-go/clientset/versioned"
+	workloadlisters "github.com/volcano-sh/kthena/client-go/listers/workload/v1alpha1"
+	workload "github.com/volcano-sh/kthena/pkg/apis/workload/v1alpha1"
+	msutils "github.com/volcano-sh/kthena/pkg/model-serving-controller/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// Entry is the value of workload.EntryLabelKey carried by a ModelServing's
+// entry pods, i.e. the pods the autoscaler should scrape metrics from.
+const Entry = "true"
+
+// GetRoleName parses a "<role>/<sub>" object reference name, as used to
+// reference a specific role replica within a ModelServing.
+func GetRoleName(ref *corev1.ObjectReference) (role, sub string, err error) {
+	parts := strings.SplitN(ref.Name, "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid role reference name %q, want \"<role>/<sub>\"", ref.Name)
+	}
+	return parts[0], parts[1], nil
+}
+
+// GetTargetLabels returns the label selector matching the pods backing
+// target.
+func GetTargetLabels(target *workload.Target) (labels.Selector, error) {
+	switch target.TargetRef.Kind {
+	case workload.ModelServingKind.Kind:
+		return msutils.LabelSelectorForModel(target.TargetRef.Name), nil
+	default:
+		return nil, fmt.Errorf("unsupported target kind %q", target.TargetRef.Kind)
+	}
+}
+
+// GetMetricPods returns the entry pods the autoscaler should scrape metrics
+// from for target, in namespace.
+func GetMetricPods(podLister corelisters.PodLister, namespace string, target *workload.Target) ([]*corev1.Pod, error) {
+	if target.TargetRef.Kind != workload.ModelServingKind.Kind {
+		return nil, fmt.Errorf("unsupported target kind %q", target.TargetRef.Kind)
+	}
+
+	selector := msutils.LabelSelectorForModel(target.TargetRef.Name, msutils.WithEntryOnly())
+	pods, err := podLister.Pods(namespace).List(selector)
+	if err != nil {
+		return nil, fmt.Errorf("listing metric pods for %s/%s: %w", namespace, target.TargetRef.Name, err)
+	}
+	return pods, nil
+}
+
+// UpdateModelServing persists model's current state.
+func UpdateModelServing(ctx context.Context, client clientset.Interface, model *workload.ModelServing) error {
+	_, err := client.WorkloadV1alpha1().ModelServings(model.Namespace).Update(ctx, model, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("updating modelserving %s/%s: %w", model.Namespace, model.Name, err)
+	}
+	return nil
+}
+
+// GetModelServingTarget returns the named ModelServing.
+func GetModelServingTarget(lister workloadlisters.ModelServingLister, namespace, name string) (*workload.ModelServing, error) {
+	ms, err := lister.ModelServings(namespace).Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting modelserving %s/%s: %w", namespace, name, err)
+	}
+	return ms, nil
+}
@@ -24,6 +24,7 @@ import (
 	clientsetfake "github.com/volcano-sh/kthena/client-go/clientset/versioned/fake"
 	workloadlisters "github.com/volcano-sh/kthena/client-go/listers/workload/v1alpha1"
 	workload "github.com/volcano-sh/kthena/pkg/apis/workload/v1alpha1"
+	msutils "github.com/volcano-sh/kthena/pkg/model-serving-controller/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeinformers "k8s.io/client-go/informers"
@@ -136,6 +137,70 @@ func TestGetMetricPods(t *testing.T) {
 	}
 }
 
+func TestGetMetricPodsMultiRole(t *testing.T) {
+	prefillEntry := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prefill-entry",
+			Namespace: "default",
+			Labels: map[string]string{
+				workload.ModelServingNameLabelKey: "model1",
+				workload.RoleLabelKey:             "prefill",
+				workload.EntryLabelKey:            Entry,
+			},
+		},
+	}
+
+	decodeEntry := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "decode-entry",
+			Namespace: "default",
+			Labels: map[string]string{
+				workload.ModelServingNameLabelKey: "model1",
+				workload.RoleLabelKey:             "decode",
+				workload.EntryLabelKey:            Entry,
+			},
+		},
+	}
+
+	// A non-entry pod for the same role should be excluded by GetMetricPods.
+	decodeWorker := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "decode-worker",
+			Namespace: "default",
+			Labels: map[string]string{
+				workload.ModelServingNameLabelKey: "model1",
+				workload.RoleLabelKey:             "decode",
+			},
+		},
+	}
+
+	kubeClient := kubefake.NewSimpleClientset(prefillEntry, decodeEntry, decodeWorker)
+	informerFactory := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	podLister := informerFactory.Core().V1().Pods().Lister()
+	informerFactory.Start(nil)
+	informerFactory.WaitForCacheSync(nil)
+
+	target := &workload.Target{}
+	target.TargetRef.Name = "model1"
+	target.TargetRef.Kind = workload.ModelServingKind.Kind
+
+	pods, err := GetMetricPods(podLister, "default", target)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pods) != 2 {
+		t.Fatalf("expected 2 entry pods across roles, got %d", len(pods))
+	}
+
+	grouped := msutils.GroupPodsByRole(pods)
+	if len(grouped["prefill"]) != 1 {
+		t.Fatalf("expected 1 prefill entry pod, got %d", len(grouped["prefill"]))
+	}
+	if len(grouped["decode"]) != 1 {
+		t.Fatalf("expected 1 decode entry pod, got %d", len(grouped["decode"]))
+	}
+}
+
 func TestUpdateModelServing(t *testing.T) {
 	client := clientsetfake.NewSimpleClientset()
 
@@ -0,0 +1,258 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	clientset "github.com/volcano-sh/kthena/client-go/clientset/versioned"
+	workloadv1alpha1 "github.com/volcano-sh/kthena/pkg/apis/workload/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ReadyChecker reports whether a single resource owned by a ModelServing has
+// become ready. It mirrors Helm 3's kube.ReadyChecker so that the same
+// per-kind readiness rules can be shared between e2e waiters and the
+// ModelServing operator controller, instead of every caller re-deriving
+// "ready" from a raw status field.
+type ReadyChecker interface {
+	// IsReady reports whether obj is ready and, when it is not, a
+	// human-readable reason suitable for logging. err is non-nil only when
+	// readiness could not be determined (e.g. an unsupported type).
+	IsReady(ctx context.Context, obj runtime.Object) (ready bool, reason string, err error)
+}
+
+// defaultReadyChecker is the ReadyChecker used by the ModelServing waiters
+// and controller. It understands every resource kind a ModelServing can
+// own: Deployments, StatefulSets, RoleSets, Pods, Services and
+// PersistentVolumeClaims.
+type defaultReadyChecker struct {
+	kubeClient kubernetes.Interface
+}
+
+// NewReadyChecker returns the default ReadyChecker. kubeClient is used to
+// resolve Endpoints for Service readiness checks.
+func NewReadyChecker(kubeClient kubernetes.Interface) ReadyChecker {
+	return &defaultReadyChecker{kubeClient: kubeClient}
+}
+
+func (c *defaultReadyChecker) IsReady(ctx context.Context, obj runtime.Object) (bool, string, error) {
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		return deploymentReady(o)
+	case *appsv1.StatefulSet:
+		return statefulSetReady(o)
+	case *workloadv1alpha1.RoleSet:
+		return roleSetReady(o)
+	case *corev1.Pod:
+		return podReady(o)
+	case *corev1.Service:
+		return c.serviceReady(ctx, o)
+	case *corev1.PersistentVolumeClaim:
+		return pvcReady(o)
+	default:
+		return false, "", fmt.Errorf("unsupported resource type %T for readiness check", obj)
+	}
+}
+
+func deploymentReady(d *appsv1.Deployment) (bool, string, error) {
+	desired := int32(1)
+	if d.Spec.Replicas != nil {
+		desired = *d.Spec.Replicas
+	}
+	if d.Status.ObservedGeneration < d.Generation {
+		return false, fmt.Sprintf("deployment %s: waiting for spec update to be observed", d.Name), nil
+	}
+	if d.Status.UpdatedReplicas < desired {
+		return false, fmt.Sprintf("deployment %s: %d/%d replicas updated", d.Name, d.Status.UpdatedReplicas, desired), nil
+	}
+	if d.Status.ReadyReplicas < desired {
+		return false, fmt.Sprintf("deployment %s: %d/%d replicas ready", d.Name, d.Status.ReadyReplicas, desired), nil
+	}
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Status == corev1.ConditionFalse {
+			return false, fmt.Sprintf("deployment %s: progress stalled: %s", d.Name, cond.Reason), nil
+		}
+		if cond.Type == appsv1.DeploymentReplicaFailure && cond.Status == corev1.ConditionTrue {
+			return false, fmt.Sprintf("deployment %s: replica failure: %s", d.Name, cond.Reason), nil
+		}
+	}
+	return true, "", nil
+}
+
+func statefulSetReady(s *appsv1.StatefulSet) (bool, string, error) {
+	desired := int32(1)
+	if s.Spec.Replicas != nil {
+		desired = *s.Spec.Replicas
+	}
+	if s.Status.ObservedGeneration < s.Generation {
+		return false, fmt.Sprintf("statefulset %s: waiting for spec update to be observed", s.Name), nil
+	}
+	if s.Status.UpdatedReplicas < desired {
+		return false, fmt.Sprintf("statefulset %s: %d/%d replicas updated", s.Name, s.Status.UpdatedReplicas, desired), nil
+	}
+	if s.Status.ReadyReplicas < desired {
+		return false, fmt.Sprintf("statefulset %s: %d/%d replicas ready", s.Name, s.Status.ReadyReplicas, desired), nil
+	}
+	return true, "", nil
+}
+
+func roleSetReady(r *workloadv1alpha1.RoleSet) (bool, string, error) {
+	desired := int32(1)
+	if r.Spec.Replicas != nil {
+		desired = *r.Spec.Replicas
+	}
+	if r.Status.ObservedGeneration < r.Generation {
+		return false, fmt.Sprintf("roleset %s: waiting for spec update to be observed", r.Name), nil
+	}
+	if r.Status.ReadyReplicas < desired {
+		return false, fmt.Sprintf("roleset %s: %d/%d replicas ready", r.Name, r.Status.ReadyReplicas, desired), nil
+	}
+	return true, "", nil
+}
+
+func podReady(p *corev1.Pod) (bool, string, error) {
+	if p.Status.Phase != corev1.PodRunning {
+		return false, fmt.Sprintf("pod %s: phase is %s", p.Name, p.Status.Phase), nil
+	}
+	for _, cs := range p.Status.ContainerStatuses {
+		if cs.State.Waiting != nil {
+			switch cs.State.Waiting.Reason {
+			case "CrashLoopBackOff", "ImagePullBackOff", "ErrImagePull":
+				return false, fmt.Sprintf("pod %s: container %s is %s: %s", p.Name, cs.Name, cs.State.Waiting.Reason, cs.State.Waiting.Message), nil
+			}
+		}
+		if !cs.Ready {
+			return false, fmt.Sprintf("pod %s: container %s is not ready", p.Name, cs.Name), nil
+		}
+	}
+	return true, "", nil
+}
+
+func (c *defaultReadyChecker) serviceReady(ctx context.Context, svc *corev1.Service) (bool, string, error) {
+	if svc.Spec.ClusterIP == corev1.ClusterIPNone {
+		// Headless services have no Endpoints aggregation to wait on.
+		return true, "", nil
+	}
+	eps, err := c.kubeClient.CoreV1().Endpoints(svc.Namespace).Get(ctx, svc.Name, metav1.GetOptions{})
+	if err != nil {
+		return false, "", fmt.Errorf("getting endpoints for service %s: %w", svc.Name, err)
+	}
+	for _, subset := range eps.Subsets {
+		if len(subset.Addresses) > 0 {
+			return true, "", nil
+		}
+	}
+	return false, fmt.Sprintf("service %s: no endpoints populated yet", svc.Name), nil
+}
+
+func pvcReady(pvc *corev1.PersistentVolumeClaim) (bool, string, error) {
+	if pvc.Status.Phase != corev1.ClaimBound {
+		return false, fmt.Sprintf("pvc %s: phase is %s, want Bound", pvc.Name, pvc.Status.Phase), nil
+	}
+	return true, "", nil
+}
+
+// ModelServingReadiness aggregates readiness across every resource kind a
+// ModelServing named `name` owns in `namespace`, using `checker` to evaluate
+// each one. It returns the first "not ready" reason it encounters so callers
+// (the e2e waiters, and the ModelServing controller itself) can surface
+// something more actionable than a bare timeout.
+func ModelServingReadiness(ctx context.Context, checker ReadyChecker, kubeClient kubernetes.Interface, kthenaClient clientset.Interface, namespace, name string) (bool, string, error) {
+	listOpts := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", workloadv1alpha1.ModelServingNameLabelKey, name),
+	}
+
+	roleSets, err := kthenaClient.WorkloadV1alpha1().RoleSets(namespace).List(ctx, listOpts)
+	if err != nil {
+		return false, "", fmt.Errorf("listing rolesets: %w", err)
+	}
+	for i := range roleSets.Items {
+		if ready, reason, err := checker.IsReady(ctx, &roleSets.Items[i]); err != nil {
+			return false, "", err
+		} else if !ready {
+			return false, reason, nil
+		}
+	}
+
+	deployments, err := kubeClient.AppsV1().Deployments(namespace).List(ctx, listOpts)
+	if err != nil {
+		return false, "", fmt.Errorf("listing deployments: %w", err)
+	}
+	for i := range deployments.Items {
+		if ready, reason, err := checker.IsReady(ctx, &deployments.Items[i]); err != nil {
+			return false, "", err
+		} else if !ready {
+			return false, reason, nil
+		}
+	}
+
+	statefulSets, err := kubeClient.AppsV1().StatefulSets(namespace).List(ctx, listOpts)
+	if err != nil {
+		return false, "", fmt.Errorf("listing statefulsets: %w", err)
+	}
+	for i := range statefulSets.Items {
+		if ready, reason, err := checker.IsReady(ctx, &statefulSets.Items[i]); err != nil {
+			return false, "", err
+		} else if !ready {
+			return false, reason, nil
+		}
+	}
+
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, listOpts)
+	if err != nil {
+		return false, "", fmt.Errorf("listing pods: %w", err)
+	}
+	for i := range pods.Items {
+		if ready, reason, err := checker.IsReady(ctx, &pods.Items[i]); err != nil {
+			return false, "", err
+		} else if !ready {
+			return false, reason, nil
+		}
+	}
+
+	services, err := kubeClient.CoreV1().Services(namespace).List(ctx, listOpts)
+	if err != nil {
+		return false, "", fmt.Errorf("listing services: %w", err)
+	}
+	for i := range services.Items {
+		if ready, reason, err := checker.IsReady(ctx, &services.Items[i]); err != nil {
+			return false, "", err
+		} else if !ready {
+			return false, reason, nil
+		}
+	}
+
+	pvcs, err := kubeClient.CoreV1().PersistentVolumeClaims(namespace).List(ctx, listOpts)
+	if err != nil {
+		return false, "", fmt.Errorf("listing persistentvolumeclaims: %w", err)
+	}
+	for i := range pvcs.Items {
+		if ready, reason, err := checker.IsReady(ctx, &pvcs.Items[i]); err != nil {
+			return false, "", err
+		} else if !ready {
+			return false, reason, nil
+		}
+	}
+
+	return true, "", nil
+}
@@ -0,0 +1,296 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	clientsetfake "github.com/volcano-sh/kthena/client-go/clientset/versioned/fake"
+	workloadv1alpha1 "github.com/volcano-sh/kthena/pkg/apis/workload/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func TestDeploymentReady(t *testing.T) {
+	cases := []struct {
+		name  string
+		dep   *appsv1.Deployment
+		ready bool
+	}{
+		{
+			name: "all replicas ready",
+			dep: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "dep1"},
+				Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+				Status:     appsv1.DeploymentStatus{UpdatedReplicas: 2, ReadyReplicas: 2},
+			},
+			ready: true,
+		},
+		{
+			name: "not enough ready replicas",
+			dep: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "dep1"},
+				Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+				Status:     appsv1.DeploymentStatus{UpdatedReplicas: 2, ReadyReplicas: 1},
+			},
+			ready: false,
+		},
+		{
+			name: "spec update not yet observed",
+			dep: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "dep1", Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(1)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, UpdatedReplicas: 1, ReadyReplicas: 1},
+			},
+			ready: false,
+		},
+		{
+			name: "nil replicas defaults to 1",
+			dep: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "dep1"},
+				Status:     appsv1.DeploymentStatus{UpdatedReplicas: 1, ReadyReplicas: 1},
+			},
+			ready: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ready, reason, err := deploymentReady(c.dep)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ready != c.ready {
+				t.Fatalf("expected ready=%v, got %v (reason: %s)", c.ready, ready, reason)
+			}
+		})
+	}
+}
+
+func TestStatefulSetReady(t *testing.T) {
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "sts1"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: int32Ptr(3)},
+		Status:     appsv1.StatefulSetStatus{UpdatedReplicas: 3, ReadyReplicas: 2},
+	}
+
+	ready, _, err := statefulSetReady(sts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ready {
+		t.Fatalf("expected statefulset with 2/3 ready replicas to not be ready")
+	}
+
+	sts.Status.ReadyReplicas = 3
+	ready, _, err = statefulSetReady(sts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ready {
+		t.Fatalf("expected statefulset with 3/3 ready replicas to be ready")
+	}
+}
+
+func TestRoleSetReady(t *testing.T) {
+	// Regression test: desired replicas must come from Spec, not Status -
+	// Status.Replicas is 0 right after creation, before any pods exist, which
+	// previously made a freshly-created RoleSet look ready immediately.
+	r := &workloadv1alpha1.RoleSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "rs1"},
+		Spec:       workloadv1alpha1.RoleSetSpec{Replicas: int32Ptr(2)},
+		Status:     workloadv1alpha1.RoleSetStatus{Replicas: 0, ReadyReplicas: 0},
+	}
+
+	ready, reason, err := roleSetReady(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ready {
+		t.Fatalf("expected roleset with 0/2 ready replicas to not be ready, got reason: %s", reason)
+	}
+
+	r.Status.ReadyReplicas = 2
+	ready, _, err = roleSetReady(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ready {
+		t.Fatalf("expected roleset with 2/2 ready replicas to be ready")
+	}
+}
+
+func TestPodReady(t *testing.T) {
+	running := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod1"},
+		Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "app", Ready: true}},
+		},
+	}
+	ready, _, err := podReady(running)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ready {
+		t.Fatalf("expected running pod with ready container to be ready")
+	}
+
+	pending := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod1"},
+		Status:     corev1.PodStatus{Phase: corev1.PodPending},
+	}
+	ready, _, err = podReady(pending)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ready {
+		t.Fatalf("expected pending pod to not be ready")
+	}
+
+	crashLooping := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod1"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "app",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			}},
+		},
+	}
+	ready, _, err = podReady(crashLooping)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ready {
+		t.Fatalf("expected crash-looping pod to not be ready")
+	}
+}
+
+func TestPVCReady(t *testing.T) {
+	bound := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc1"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+	}
+	ready, _, err := pvcReady(bound)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ready {
+		t.Fatalf("expected bound pvc to be ready")
+	}
+
+	pending := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc1"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+	}
+	ready, _, err = pvcReady(pending)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ready {
+		t.Fatalf("expected pending pvc to not be ready")
+	}
+}
+
+func TestServiceReady(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1"},
+	}
+	eps := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "default"},
+		Subsets: []corev1.EndpointSubset{{
+			Addresses: []corev1.EndpointAddress{{IP: "10.0.0.2"}},
+		}},
+	}
+
+	kubeClient := kubefake.NewSimpleClientset(svc, eps)
+	checker := NewReadyChecker(kubeClient)
+
+	ready, _, err := checker.IsReady(context.Background(), svc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ready {
+		t.Fatalf("expected service with populated endpoints to be ready")
+	}
+}
+
+func TestServiceReadyHeadless(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{ClusterIP: corev1.ClusterIPNone},
+	}
+
+	kubeClient := kubefake.NewSimpleClientset(svc)
+	checker := NewReadyChecker(kubeClient)
+
+	ready, _, err := checker.IsReady(context.Background(), svc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ready {
+		t.Fatalf("expected headless service to be ready without endpoints")
+	}
+}
+
+func TestModelServingReadiness(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod1",
+			Namespace: "default",
+			Labels:    map[string]string{workloadv1alpha1.ModelServingNameLabelKey: "model1"},
+		},
+		Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "app", Ready: true}},
+		},
+	}
+
+	kubeClient := kubefake.NewSimpleClientset(pod)
+	kthenaClient := clientsetfake.NewSimpleClientset()
+	checker := NewReadyChecker(kubeClient)
+
+	ready, reason, err := ModelServingReadiness(context.Background(), checker, kubeClient, kthenaClient, "default", "model1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !ready {
+		t.Fatalf("expected ModelServing to be ready, got reason: %s", reason)
+	}
+
+	pod.Status.ContainerStatuses[0].Ready = false
+	kubeClient = kubefake.NewSimpleClientset(pod)
+	checker = NewReadyChecker(kubeClient)
+
+	ready, reason, err = ModelServingReadiness(context.Background(), checker, kubeClient, kthenaClient, "default", "model1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ready {
+		t.Fatalf("expected ModelServing with unready container to not be ready")
+	}
+	if reason == "" {
+		t.Fatalf("expected a non-empty reason for a not-ready ModelServing")
+	}
+}
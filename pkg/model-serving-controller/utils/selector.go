@@ -0,0 +1,79 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+
+	workloadv1alpha1 "github.com/volcano-sh/kthena/pkg/apis/workload/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// SelectorOption narrows the label selector built by LabelSelectorForModel.
+type SelectorOption func(labels.Set)
+
+// WithRole restricts the selector to pods belonging to roleName.
+func WithRole(roleName string) SelectorOption {
+	return func(set labels.Set) { set[workloadv1alpha1.RoleLabelKey] = roleName }
+}
+
+// WithRoleID restricts the selector to pods belonging to roleID.
+func WithRoleID(roleID string) SelectorOption {
+	return func(set labels.Set) { set[workloadv1alpha1.RoleIDKey] = roleID }
+}
+
+// WithEntryOnly restricts the selector to the ModelServing's entry pods.
+func WithEntryOnly() SelectorOption {
+	return func(set labels.Set) { set[workloadv1alpha1.EntryLabelKey] = "true" }
+}
+
+// LabelSelectorForModel returns the label selector matching every pod
+// belonging to the ModelServing named modelServingName, optionally narrowed
+// by SelectorOptions such as WithRole, WithRoleID or WithEntryOnly. This is
+// the single place that knows how a ModelServing's pods are labeled, so
+// autoscaler/router code paths don't each re-implement label matching.
+func LabelSelectorForModel(modelServingName string, opts ...SelectorOption) labels.Selector {
+	set := labels.Set{workloadv1alpha1.ModelServingNameLabelKey: modelServingName}
+	for _, opt := range opts {
+		opt(set)
+	}
+	return labels.SelectorFromSet(set)
+}
+
+// GetPodsForRole returns the pods belonging to roleName within the
+// ModelServing named modelServingName.
+func GetPodsForRole(lister corelisters.PodLister, namespace, modelServingName, roleName string) ([]*corev1.Pod, error) {
+	pods, err := lister.Pods(namespace).List(LabelSelectorForModel(modelServingName, WithRole(roleName)))
+	if err != nil {
+		return nil, fmt.Errorf("listing pods for role %s: %w", roleName, err)
+	}
+	return pods, nil
+}
+
+// GroupPodsByRole buckets an already-fetched pod list by role name, so
+// callers that list once with a broader selector don't need to re-list per
+// role.
+func GroupPodsByRole(pods []*corev1.Pod) map[string][]*corev1.Pod {
+	grouped := make(map[string][]*corev1.Pod)
+	for _, pod := range pods {
+		role := GetRoleName(pod)
+		grouped[role] = append(grouped[role], pod)
+	}
+	return grouped
+}
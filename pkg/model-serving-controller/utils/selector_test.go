@@ -0,0 +1,108 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	workloadv1alpha1 "github.com/volcano-sh/kthena/pkg/apis/workload/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func newRolePod(name, modelServingName, role string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				workloadv1alpha1.ModelServingNameLabelKey: modelServingName,
+				workloadv1alpha1.RoleLabelKey:             role,
+			},
+		},
+	}
+}
+
+func TestGetPodsForRole(t *testing.T) {
+	prefill := newRolePod("pod-prefill-0", "model1", "prefill")
+	decode := newRolePod("pod-decode-0", "model1", "decode")
+	other := newRolePod("pod-other-0", "other-model", "prefill")
+
+	kubeClient := kubefake.NewSimpleClientset(prefill, decode, other)
+	informerFactory := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	podLister := informerFactory.Core().V1().Pods().Lister()
+	informerFactory.Start(nil)
+	informerFactory.WaitForCacheSync(nil)
+
+	pods, err := GetPodsForRole(podLister, "default", "model1", "prefill")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pods) != 1 || pods[0].Name != "pod-prefill-0" {
+		t.Fatalf("expected only pod-prefill-0, got %v", pods)
+	}
+
+	pods, err = GetPodsForRole(podLister, "default", "model1", "decode")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pods) != 1 || pods[0].Name != "pod-decode-0" {
+		t.Fatalf("expected only pod-decode-0, got %v", pods)
+	}
+}
+
+func TestGroupPodsByRole(t *testing.T) {
+	pods := []*corev1.Pod{
+		newRolePod("pod-prefill-0", "model1", "prefill"),
+		newRolePod("pod-prefill-1", "model1", "prefill"),
+		newRolePod("pod-decode-0", "model1", "decode"),
+	}
+
+	grouped := GroupPodsByRole(pods)
+	if len(grouped) != 2 {
+		t.Fatalf("expected 2 roles, got %d", len(grouped))
+	}
+	if len(grouped["prefill"]) != 2 {
+		t.Fatalf("expected 2 prefill pods, got %d", len(grouped["prefill"]))
+	}
+	if len(grouped["decode"]) != 1 {
+		t.Fatalf("expected 1 decode pod, got %d", len(grouped["decode"]))
+	}
+}
+
+func TestLabelSelectorForModel(t *testing.T) {
+	selector := LabelSelectorForModel("model1", WithRole("prefill"))
+
+	matches := selector.Matches(labels.Set{
+		workloadv1alpha1.ModelServingNameLabelKey: "model1",
+		workloadv1alpha1.RoleLabelKey:             "prefill",
+	})
+	if !matches {
+		t.Fatalf("expected selector to match model1/prefill labels")
+	}
+
+	matches = selector.Matches(labels.Set{
+		workloadv1alpha1.ModelServingNameLabelKey: "model1",
+		workloadv1alpha1.RoleLabelKey:             "decode",
+	})
+	if matches {
+		t.Fatalf("expected selector to not match model1/decode labels")
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package otelspan is a thin, OpenTelemetry-shaped seam that lets
+// framework.TestTimer forward its phase spans to an OTLP collector without
+// framework hard-depending on the OTel SDK at build time. Callers who want
+// real OTLP export wire in an Exporter backed by go.opentelemetry.io/otel
+// from their own test main(); framework never imports that SDK itself.
+package otelspan
+
+import (
+	"context"
+	"time"
+)
+
+// Span is a minimal, SDK-agnostic description of a completed phase span.
+type Span struct {
+	Name         string
+	TraceID      string
+	SpanID       string
+	ParentSpanID string
+	StartTime    time.Time
+	EndTime      time.Time
+}
+
+// Exporter sends a completed Span to wherever spans go (an OTLP collector,
+// a log, a test double). Implementations are expected to be fast and
+// non-blocking; ExportSpan is called synchronously from the test goroutine.
+type Exporter interface {
+	ExportSpan(ctx context.Context, span Span) error
+}
@@ -0,0 +1,190 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/volcano-sh/kthena/test/e2e/framework/otelspan"
+)
+
+// spanNode is one entry in a TestTimer's nested-phase tree, e.g. "pull
+// image" -> "wait for model ready" -> "run inference".
+type spanNode struct {
+	id       string
+	name     string
+	start    time.Time
+	end      time.Time
+	parent   *spanNode
+	children []*spanNode
+}
+
+// PhaseHandle is returned by StartPhase; call End when the phase completes.
+type PhaseHandle struct {
+	tt   *TestTimer
+	gid  int64
+	span *spanNode
+}
+
+var spanIDSeq int64
+
+// StartPhase begins a new named, nestable phase. If another phase is
+// already open on the same goroutine, the new phase is recorded as its
+// child; otherwise it starts a new root span. Call the returned handle's
+// End method when the phase completes.
+func (tt *TestTimer) StartPhase(name string) PhaseHandle {
+	gid := goroutineID()
+	span := &spanNode{
+		id:    strconv.FormatInt(atomic.AddInt64(&spanIDSeq, 1), 10),
+		name:  name,
+		start: time.Now(),
+	}
+
+	tt.spanMu.Lock()
+	stack := tt.spanStacks[gid]
+	if len(stack) > 0 {
+		parent := stack[len(stack)-1]
+		parent.children = append(parent.children, span)
+		span.parent = parent
+	} else {
+		tt.spanRoots = append(tt.spanRoots, span)
+	}
+	tt.spanStacks[gid] = append(stack, span)
+	tt.spanMu.Unlock()
+
+	return PhaseHandle{tt: tt, gid: gid, span: span}
+}
+
+// End closes the phase, recording its duration and, if a span exporter is
+// registered and OTEL_EXPORTER_OTLP_ENDPOINT is set, forwarding it as an
+// OpenTelemetry-style span.
+func (h PhaseHandle) End() {
+	h.span.end = time.Now()
+
+	h.tt.spanMu.Lock()
+	stack := h.tt.spanStacks[h.gid]
+	if n := len(stack); n > 0 && stack[n-1] == h.span {
+		h.tt.spanStacks[h.gid] = stack[:n-1]
+	}
+	h.tt.spanMu.Unlock()
+
+	duration := h.span.end.Sub(h.span.start)
+	fmt.Printf("[E2E TIMING] %s: Phase '%s' completed in %v\n", h.tt.suiteName, h.span.name, duration.Round(time.Millisecond*100))
+
+	h.tt.mu.Lock()
+	h.tt.records = append(h.tt.records, TimingRecord{
+		Suite: h.tt.suiteName, Phase: "phase", Name: h.span.name,
+		Start: h.span.start, End: h.span.end, DurationNS: duration.Nanoseconds(),
+	})
+	h.tt.mu.Unlock()
+
+	exportSpan(h.tt, h.span)
+}
+
+// exportSpan forwards span to the registered otelspan.Exporter, if any, when
+// the user has opted in via OTEL_EXPORTER_OTLP_ENDPOINT. It is a no-op
+// otherwise, so framework never depends on a real OTel SDK being present.
+func exportSpan(tt *TestTimer, span *spanNode) {
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" {
+		return
+	}
+	exporter := GetSpanExporter()
+	if exporter == nil {
+		return
+	}
+
+	parentID := ""
+	if span.parent != nil {
+		parentID = span.parent.id
+	}
+	err := exporter.ExportSpan(context.Background(), otelspan.Span{
+		Name:         span.name,
+		TraceID:      tt.suiteName,
+		SpanID:       span.id,
+		ParentSpanID: parentID,
+		StartTime:    span.start,
+		EndTime:      span.end,
+	})
+	if err != nil {
+		fmt.Printf("[E2E TIMING] %s: failed to export span '%s': %v\n", tt.suiteName, span.name, err)
+	}
+}
+
+var globalSpanExporter otelspan.Exporter
+
+// SetSpanExporter registers the adapter used to forward phase spans to an
+// OTLP collector. framework itself never imports the OTel SDK; callers wire
+// one in from their test main() when OTEL_EXPORTER_OTLP_ENDPOINT is set.
+func SetSpanExporter(exporter otelspan.Exporter) {
+	globalSpanExporter = exporter
+}
+
+// GetSpanExporter returns the currently registered span exporter, or nil.
+func GetSpanExporter() otelspan.Exporter {
+	return globalSpanExporter
+}
+
+// PrintPhaseTree renders every root phase span recorded via StartPhase as an
+// indented tree, showing both total time (including children) and self time
+// (excluding children) for each span.
+func (tt *TestTimer) PrintPhaseTree() {
+	tt.spanMu.Lock()
+	defer tt.spanMu.Unlock()
+
+	if len(tt.spanRoots) == 0 {
+		return
+	}
+
+	fmt.Printf("[E2E TIMING] %s: Phase tree (total / self):\n", tt.suiteName)
+	for _, root := range tt.spanRoots {
+		printSpanNode(root, 0)
+	}
+}
+
+func printSpanNode(n *spanNode, depth int) {
+	total := n.end.Sub(n.start)
+	var childTotal time.Duration
+	for _, c := range n.children {
+		childTotal += c.end.Sub(c.start)
+	}
+	self := total - childTotal
+	fmt.Printf("%s- %s: total=%v self=%v\n", strings.Repeat("  ", depth), n.name, total.Round(time.Millisecond), self.Round(time.Millisecond))
+	for _, c := range n.children {
+		printSpanNode(c, depth+1)
+	}
+}
+
+// goroutineID extracts the current goroutine's id from its stack trace
+// header ("goroutine 123 [running]:"), so StartPhase/End can nest phases
+// per-goroutine without threading a context.Context through every call site.
+func goroutineID() int64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	field := strings.Fields(strings.TrimPrefix(string(buf[:n]), "goroutine "))[0]
+	id, err := strconv.ParseInt(field, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
@@ -0,0 +1,68 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import "testing"
+
+func TestStartPhaseRecordsNesting(t *testing.T) {
+	tt := NewTestTimer("mysuite")
+
+	outer := tt.StartPhase("outer")
+	inner := tt.StartPhase("inner")
+	inner.End()
+	outer.End()
+
+	if len(tt.spanRoots) != 1 {
+		t.Fatalf("expected 1 root span, got %d", len(tt.spanRoots))
+	}
+	root := tt.spanRoots[0]
+	if root.name != "outer" {
+		t.Fatalf("expected root span 'outer', got %q", root.name)
+	}
+	if len(root.children) != 1 || root.children[0].name != "inner" {
+		t.Fatalf("expected 'outer' to have one child 'inner', got %v", root.children)
+	}
+
+	phaseRecords := 0
+	for _, r := range tt.records {
+		if r.Phase == "phase" {
+			phaseRecords++
+		}
+	}
+	if phaseRecords != 2 {
+		t.Fatalf("expected 2 phase records, got %d", phaseRecords)
+	}
+}
+
+func TestStartPhaseSiblingsNotNested(t *testing.T) {
+	tt := NewTestTimer("mysuite")
+
+	first := tt.StartPhase("first")
+	first.End()
+	second := tt.StartPhase("second")
+	second.End()
+
+	if len(tt.spanRoots) != 2 {
+		t.Fatalf("expected 2 sibling root spans, got %d", len(tt.spanRoots))
+	}
+}
+
+func TestSpanExporterRegistration(t *testing.T) {
+	if GetSpanExporter() != nil {
+		t.Fatalf("expected no exporter registered by default")
+	}
+}
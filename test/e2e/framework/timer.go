@@ -17,6 +17,8 @@ limitations under the License.
 package framework
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"os"
 	"runtime"
@@ -26,15 +28,35 @@ import (
 	"time"
 )
 
+// TimingRecord is a single phase/test timing entry, suitable for exporting
+// to JSON and merging across suite runs.
+type TimingRecord struct {
+	Suite      string    `json:"suite"`
+	Phase      string    `json:"phase"` // "setup", "test", "cleanup" or "phase" (a StartPhase span)
+	Name       string    `json:"name"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	DurationNS int64     `json:"duration_ns"`
+}
+
 // TestTimer tracks timing information for e2e tests
 type TestTimer struct {
-	suiteName      string
-	suiteStartTime time.Time
-	setupStartTime time.Time
-	setupDuration  time.Duration
-	testDurations  map[string]time.Duration
-	testStartTimes map[string]time.Time
-	mu             sync.Mutex
+	suiteName        string
+	suiteStartTime   time.Time
+	setupStartTime   time.Time
+	setupDuration    time.Duration
+	cleanupStartTime time.Time
+	cleanupDuration  time.Duration
+	testDurations    map[string]time.Duration
+	testStartTimes   map[string]time.Time
+	records          []TimingRecord
+	mu               sync.Mutex
+
+	// Nested phase spans (see phases.go), guarded separately from mu since
+	// StartPhase/End are called far more often than the setup/test hooks.
+	spanStacks map[int64][]*spanNode
+	spanRoots  []*spanNode
+	spanMu     sync.Mutex
 }
 
 // NewTestTimer creates a new TestTimer for a test suite
@@ -44,6 +66,7 @@ func NewTestTimer(suiteName string) *TestTimer {
 		suiteStartTime: time.Now(),
 		testDurations:  make(map[string]time.Duration),
 		testStartTimes: make(map[string]time.Time),
+		spanStacks:     make(map[int64][]*spanNode),
 	}
 }
 
@@ -65,11 +88,38 @@ func (tt *TestTimer) StartSetup(phase string) {
 func (tt *TestTimer) EndSetup(phase string) {
 	tt.mu.Lock()
 	defer tt.mu.Unlock()
-	duration := time.Since(tt.setupStartTime)
+	end := time.Now()
+	duration := end.Sub(tt.setupStartTime)
 	tt.setupDuration += duration
+	tt.records = append(tt.records, TimingRecord{
+		Suite: tt.suiteName, Phase: "setup", Name: phase,
+		Start: tt.setupStartTime, End: end, DurationNS: duration.Nanoseconds(),
+	})
 	fmt.Printf("[E2E TIMING] %s: Setup phase '%s' completed in %v\n", tt.suiteName, phase, duration.Round(time.Second))
 }
 
+// StartCleanup marks the start of a cleanup phase (e.g. namespace teardown)
+func (tt *TestTimer) StartCleanup(phase string) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	tt.cleanupStartTime = time.Now()
+	fmt.Printf("[E2E TIMING] %s: Cleanup phase '%s' started\n", tt.suiteName, phase)
+}
+
+// EndCleanup marks the end of a cleanup phase
+func (tt *TestTimer) EndCleanup(phase string) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	end := time.Now()
+	duration := end.Sub(tt.cleanupStartTime)
+	tt.cleanupDuration += duration
+	tt.records = append(tt.records, TimingRecord{
+		Suite: tt.suiteName, Phase: "cleanup", Name: phase,
+		Start: tt.cleanupStartTime, End: end, DurationNS: duration.Nanoseconds(),
+	})
+	fmt.Printf("[E2E TIMING] %s: Cleanup phase '%s' completed in %v\n", tt.suiteName, phase, duration.Round(time.Second))
+}
+
 // StartTest marks the start of a test
 func (tt *TestTimer) StartTest(testName string) {
 	tt.mu.Lock()
@@ -82,8 +132,13 @@ func (tt *TestTimer) EndTest(testName string) {
 	tt.mu.Lock()
 	defer tt.mu.Unlock()
 	if startTime, exists := tt.testStartTimes[testName]; exists {
-		duration := time.Since(startTime)
+		end := time.Now()
+		duration := end.Sub(startTime)
 		tt.testDurations[testName] = duration
+		tt.records = append(tt.records, TimingRecord{
+			Suite: tt.suiteName, Phase: "test", Name: testName,
+			Start: startTime, End: end, DurationNS: duration.Nanoseconds(),
+		})
 		fmt.Printf("[E2E TIMING] %s: Test '%s' completed in %v\n", tt.suiteName, testName, duration.Round(time.Millisecond*100))
 		delete(tt.testStartTimes, testName)
 	}
@@ -123,7 +178,10 @@ func (tt *TestTimer) PrintSummary() {
 	fmt.Printf("║ Test Execution: %-45s ║\n", testDuration.Round(time.Second))
 	
 	// Other time (cleanup, etc.)
-	otherDuration := totalDuration - tt.setupDuration - testDuration
+	otherDuration := tt.cleanupDuration
+	if otherDuration == 0 {
+		otherDuration = totalDuration - tt.setupDuration - testDuration
+	}
 	if otherDuration > 0 {
 		fmt.Printf("║ Cleanup/Other: %-46s ║\n", otherDuration.Round(time.Second))
 	}
@@ -240,11 +298,86 @@ func (tt *TestTimer) RunTests(m *testing.M) int {
 	tt.StartSuite()
 	code := m.Run()
 	tt.PrintSummary()
-	
+	tt.PrintPhaseTree()
+
 	// Print CI format if running in CI
 	if os.Getenv("CI") == "true" || os.Getenv("GITHUB_ACTIONS") == "true" {
 		tt.PrintCIFormat()
 	}
 	
+	if out := os.Getenv("KTHENA_E2E_JUNIT_OUT"); out != "" {
+		if err := tt.WriteJUnitXML(out); err != nil {
+			fmt.Printf("[E2E TIMING] failed to write JUnit XML to %s: %v\n", out, err)
+		}
+	}
+	if out := os.Getenv("KTHENA_E2E_JSON_OUT"); out != "" {
+		if err := tt.WriteJSON(out); err != nil {
+			fmt.Printf("[E2E TIMING] failed to write JSON timing to %s: %v\n", out, err)
+		}
+	}
+	
 	return code
 }
+
+// junitTestCase is a single <testcase> element of a JUnit XML report.
+type junitTestCase struct {
+	Name      string `xml:"name,attr"`
+	ClassName string `xml:"classname,attr"`
+	Time      string `xml:"time,attr"`
+}
+
+// junitTestSuite is the <testsuite> root of a JUnit XML report.
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+// WriteJUnitXML writes a JUnit-compatible XML report to path, so CI systems
+// such as Jenkins, GitLab or Prow can ingest e2e timings. Setup is included
+// as a synthetic testcase alongside the real tests.
+func (tt *TestTimer) WriteJUnitXML(path string) error {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	suite := junitTestSuite{
+		Name: tt.suiteName,
+		TestCases: []junitTestCase{
+			{Name: "setup", ClassName: tt.suiteName, Time: fmt.Sprintf("%.3f", tt.setupDuration.Seconds())},
+		},
+	}
+	total := tt.setupDuration
+	for name, duration := range tt.testDurations {
+		suite.TestCases = append(suite.TestCases, junitTestCase{
+			Name:      name,
+			ClassName: tt.suiteName,
+			Time:      fmt.Sprintf("%.3f", duration.Seconds()),
+		})
+		total += duration
+	}
+	suite.Tests = len(suite.TestCases)
+	suite.Time = fmt.Sprintf("%.3f", total.Seconds())
+
+	data, err := xml.MarshalIndent(suite, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal junit xml: %w", err)
+	}
+	data = append([]byte(xml.Header), data...)
+	return os.WriteFile(path, data, 0644)
+}
+
+// WriteJSON writes every recorded setup/test/cleanup phase and StartPhase
+// span as a JSON array of TimingRecord to path, for aggregation across suites (see
+// cmd/e2e-timing-merge).
+func (tt *TestTimer) WriteJSON(path string) error {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	data, err := json.MarshalIndent(tt.records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal timing json: %w", err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTimerRecordsSetupTestCleanup(t *testing.T) {
+	tt := NewTestTimer("mysuite")
+
+	tt.StartSetup("install-crds")
+	tt.EndSetup("install-crds")
+
+	tt.StartTest("TestFoo")
+	tt.EndTest("TestFoo")
+
+	tt.StartCleanup("delete-namespace")
+	tt.EndCleanup("delete-namespace")
+
+	if len(tt.records) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(tt.records))
+	}
+
+	phases := map[string]bool{}
+	for _, r := range tt.records {
+		if r.Suite != "mysuite" {
+			t.Fatalf("expected suite mysuite, got %s", r.Suite)
+		}
+		phases[r.Phase] = true
+	}
+	for _, want := range []string{"setup", "test", "cleanup"} {
+		if !phases[want] {
+			t.Fatalf("expected a %q record, got %v", want, tt.records)
+		}
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	tt := NewTestTimer("mysuite")
+	tt.StartTest("TestFoo")
+	tt.EndTest("TestFoo")
+
+	path := filepath.Join(t.TempDir(), "timing.json")
+	if err := tt.WriteJSON(path); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading %s: %v", path, err)
+	}
+
+	var records []TimingRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(records) != 1 || records[0].Name != "TestFoo" {
+		t.Fatalf("expected one TestFoo record, got %v", records)
+	}
+}
+
+func TestWriteJUnitXML(t *testing.T) {
+	tt := NewTestTimer("mysuite")
+	tt.StartSetup("install-crds")
+	tt.EndSetup("install-crds")
+	tt.StartTest("TestFoo")
+	tt.EndTest("TestFoo")
+
+	path := filepath.Join(t.TempDir(), "junit.xml")
+	if err := tt.WriteJUnitXML(path); err != nil {
+		t.Fatalf("WriteJUnitXML: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading %s: %v", path, err)
+	}
+
+	var suite junitTestSuite
+	if err := xml.Unmarshal(data, &suite); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if suite.Name != "mysuite" {
+		t.Fatalf("expected suite name mysuite, got %s", suite.Name)
+	}
+	// setup + TestFoo
+	if len(suite.TestCases) != 2 {
+		t.Fatalf("expected 2 testcases, got %d", len(suite.TestCases))
+	}
+}
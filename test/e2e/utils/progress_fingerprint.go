@@ -0,0 +1,57 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	workloadv1alpha1 "github.com/volcano-sh/kthena/pkg/apis/workload/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// podProgressFingerprint summarizes per-pod, per-container phase/readiness
+// state for the named ModelServing. Unlike Status.AvailableReplicas - which
+// for a single-replica ModelServing only ever transitions 0->1 once, right
+// at the end of a rollout - this changes on every intermediate step (image
+// pull completing, a container starting, a readiness probe passing), so it
+// is a much finer-grained "are we still making progress" signal for stall
+// detection. This is e2e-waiter-specific diagnostics rather than a readiness
+// rule, so it stays here instead of moving to msutils alongside ReadyChecker.
+func podProgressFingerprint(ctx context.Context, kubeClient kubernetes.Interface, namespace, name string) (string, error) {
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", workloadv1alpha1.ModelServingNameLabelKey, name),
+	})
+	if err != nil {
+		return "", fmt.Errorf("listing pods: %w", err)
+	}
+
+	var b strings.Builder
+	for _, pod := range pods.Items {
+		fmt.Fprintf(&b, "%s=%s;", pod.Name, pod.Status.Phase)
+		for _, cs := range pod.Status.ContainerStatuses {
+			waitingReason := ""
+			if cs.State.Waiting != nil {
+				waitingReason = cs.State.Waiting.Reason
+			}
+			fmt.Fprintf(&b, "%s/%s:ready=%t,restarts=%d,waiting=%s;", pod.Name, cs.Name, cs.Ready, cs.RestartCount, waitingReason)
+		}
+	}
+	return b.String(), nil
+}
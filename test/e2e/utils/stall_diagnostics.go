@@ -0,0 +1,72 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	workloadv1alpha1 "github.com/volcano-sh/kthena/pkg/apis/workload/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// dumpStallDiagnostics logs the last eventTail Warning events for every pod
+// owned by the named ModelServing, plus each pod's last termination state and
+// current waiting reason, so a stalled rollout fails with something
+// actionable instead of a bare timeout.
+func dumpStallDiagnostics(ctx context.Context, logf func(format string, args ...interface{}), kubeClient kubernetes.Interface, namespace, name string, eventTail int) {
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", workloadv1alpha1.ModelServingNameLabelKey, name),
+	})
+	if err != nil {
+		logf("stall diagnostics: failed to list pods: %v", err)
+		return
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		logf("stall diagnostics: pod %s/%s phase=%s", pod.Namespace, pod.Name, pod.Status.Phase)
+
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Waiting != nil {
+				logf("  container %s waiting: reason=%s message=%s", cs.Name, cs.State.Waiting.Reason, cs.State.Waiting.Message)
+			}
+			if cs.LastTerminationState.Terminated != nil {
+				term := cs.LastTerminationState.Terminated
+				logf("  container %s last terminated: reason=%s exitCode=%d message=%s", cs.Name, term.Reason, term.ExitCode, term.Message)
+			}
+		}
+
+		events, err := kubeClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+			FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s,type=%s", pod.Name, namespace, corev1.EventTypeWarning),
+		})
+		if err != nil {
+			logf("  failed to list events for pod %s: %v", pod.Name, err)
+			continue
+		}
+
+		items := events.Items
+		if len(items) > eventTail {
+			items = items[len(items)-eventTail:]
+		}
+		for _, ev := range items {
+			logf("  event: reason=%s message=%s count=%d lastSeen=%s", ev.Reason, ev.Message, ev.Count, ev.LastTimestamp)
+		}
+	}
+}
@@ -24,46 +24,87 @@ import (
 
 	"github.com/stretchr/testify/require"
 	clientset "github.com/volcano-sh/kthena/client-go/clientset/versioned"
+	msutils "github.com/volcano-sh/kthena/pkg/model-serving-controller/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 )
 
-// WaitForModelServingReady waits for a ModelServing to become ready by checking
-// if all expected replicas are available.
-func WaitForModelServingReady(t *testing.T, ctx context.Context, kthenaClient *clientset.Clientset, namespace, name string) {
-	t.Log("Waiting for ModelServing to be ready...")
-	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
-	defer cancel()
-	err := wait.PollUntilContextTimeout(timeoutCtx, 5*time.Second, 5*time.Minute, true, func(ctx context.Context) (bool, error) {
-		ms, err := kthenaClient.WorkloadV1alpha1().ModelServings(namespace).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			t.Logf("Error getting ModelServing %s, retrying: %v", name, err)
-			return false, err
-		}
-		// Check if all replicas are available
-		expectedReplicas := int32(1)
-		if ms.Spec.Replicas != nil {
-			expectedReplicas = *ms.Spec.Replicas
-		}
-		return ms.Status.AvailableReplicas >= expectedReplicas, nil
-	})
+// WaitForModelServingReady waits for a ModelServing to become ready. Readiness
+// is aggregated across every resource the ModelServing owns (Deployments,
+// StatefulSets, RoleSets, Pods, Services and PVCs) via a ReadyChecker, rather
+// than relying solely on Status.AvailableReplicas. The deadline does not
+// extend on progress and stall detection is disabled by default - opt in
+// with WithStallTimeout if the caller wants it; use
+// WaitForModelServingReadyWithProgressExtend for both.
+func WaitForModelServingReady(t *testing.T, ctx context.Context, kthenaClient *clientset.Clientset, kubeClient kubernetes.Interface, namespace, name string, opts ...WaitOption) {
+	cfg := &waitConfig{
+		initialDeadline: 5 * time.Minute,
+		hardDeadline:    5 * time.Minute,
+		pollInterval:    5 * time.Second,
+		stallTimeout:    0, // no early-abort: this waiter only ever had a flat timeout.
+		eventTail:       5,
+		logf:            t.Logf,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	err := waitForModelServingReady(t, ctx, kthenaClient, kubeClient, namespace, name, cfg)
 	require.NoError(t, err, "ModelServing did not become ready")
 }
 
-// WaitForModelServingReadyWithProgressExtend waits for ModelServing to become ready.
-// The deadline extends by 2min each time AvailableReplicas increases, up to 15min hard max.
-func WaitForModelServingReadyWithProgressExtend(t *testing.T, ctx context.Context, kthenaClient *clientset.Clientset, namespace, name string) {
-	t.Log("Waiting for ModelServing to be ready (with progress-based deadline extension)...")
+// WaitForModelServingReadyWithProgressExtend waits for ModelServing to become
+// ready, extending the deadline by 2min each time progress is observed, up to
+// a 15min hard max. It shares its polling, readiness aggregation and stall
+// detection with WaitForModelServingReady. The default stall timeout (5min)
+// is well above the time a single-replica ModelServing can spend pulling a
+// multi-GB model image with no AvailableReplicas movement at all.
+func WaitForModelServingReadyWithProgressExtend(t *testing.T, ctx context.Context, kthenaClient *clientset.Clientset, kubeClient kubernetes.Interface, namespace, name string, opts ...WaitOption) {
+	cfg := &waitConfig{
+		initialDeadline: 5 * time.Minute,
+		hardDeadline:    15 * time.Minute,
+		pollInterval:    5 * time.Second,
+		stallTimeout:    5 * time.Minute,
+		eventTail:       5,
+		logf:            t.Logf,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	err := waitForModelServingReady(t, ctx, kthenaClient, kubeClient, namespace, name, cfg)
+	require.NoError(t, err, "ModelServing did not become ready")
+}
+
+// waitForModelServingReady is the shared implementation behind both public
+// waiters. It polls ModelServing + owned-resource readiness, extends the
+// deadline by 2min whenever AvailableReplicas increases or the per-pod
+// progress fingerprint changes (capped at cfg.hardDeadline), and - if
+// cfg.stallTimeout is non-zero - fails fast with pod-event diagnostics once
+// that much time passes with neither signal moving.
+//
+// AvailableReplicas alone is too coarse for this: a single-replica
+// ModelServing (the common case) only flips it 0->1 once, right at the end
+// of a rollout, so a pod that is still pulling a large model image would
+// otherwise look indistinguishable from a genuinely stuck one. The
+// fingerprint picks up intermediate steps - image pull completing, a
+// container starting, a readiness probe passing - well before that.
+func waitForModelServingReady(t *testing.T, ctx context.Context, kthenaClient *clientset.Clientset, kubeClient kubernetes.Interface, namespace, name string, cfg *waitConfig) error {
+	cfg.logf("Waiting for ModelServing %s to be ready...", name)
+	checker := msutils.NewReadyChecker(kubeClient)
+
 	start := time.Now()
-	initialDeadline := start.Add(5 * time.Minute)
-	hardDeadline := start.Add(15 * time.Minute)
-	deadline := initialDeadline
+	deadline := start.Add(cfg.initialDeadline)
+	hardDeadline := start.Add(cfg.hardDeadline)
 	lastAvailable := int32(-1)
+	lastFingerprint := ""
+	lastProgress := start
 
-	timeoutCtx, cancel := context.WithTimeout(ctx, 15*time.Minute)
+	timeoutCtx, cancel := context.WithTimeout(ctx, cfg.hardDeadline)
 	defer cancel()
 
-	err := wait.PollUntilContextTimeout(timeoutCtx, 5*time.Second, 15*time.Minute, true, func(ctx context.Context) (bool, error) {
+	return wait.PollUntilContextTimeout(timeoutCtx, cfg.pollInterval, cfg.hardDeadline, true, func(ctx context.Context) (bool, error) {
 		now := time.Now()
 		if now.After(deadline) {
 			return false, fmt.Errorf("deadline exceeded: ModelServing did not become ready within timeout (last extended: %v)", deadline.Sub(start))
@@ -71,8 +112,8 @@ func WaitForModelServingReadyWithProgressExtend(t *testing.T, ctx context.Contex
 
 		ms, err := kthenaClient.WorkloadV1alpha1().ModelServings(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
-			t.Logf("Error getting ModelServing %s, retrying: %v", name, err)
-			return false, err
+			cfg.logf("Error getting ModelServing %s, retrying: %v", name, err)
+			return false, nil
 		}
 
 		expectedReplicas := int32(1)
@@ -80,27 +121,51 @@ func WaitForModelServingReadyWithProgressExtend(t *testing.T, ctx context.Contex
 			expectedReplicas = *ms.Spec.Replicas
 		}
 
+		ready := false
+		reason := fmt.Sprintf("%d/%d replicas available", ms.Status.AvailableReplicas, expectedReplicas)
 		if ms.Status.AvailableReplicas >= expectedReplicas {
+			ready, reason, err = msutils.ModelServingReadiness(ctx, checker, kubeClient, kthenaClient, namespace, name)
+			if err != nil {
+				cfg.logf("Error checking readiness for ModelServing %s, retrying: %v", name, err)
+				return false, nil
+			}
+		}
+		if ready {
 			return true, nil
 		}
+		cfg.logf("Not ready yet: %s", reason)
 
-		if ms.Status.AvailableReplicas > lastAvailable {
+		progressed := ms.Status.AvailableReplicas > lastAvailable
+		if progressed {
 			lastAvailable = ms.Status.AvailableReplicas
+		}
+
+		fingerprint, fpErr := podProgressFingerprint(ctx, kubeClient, namespace, name)
+		if fpErr != nil {
+			cfg.logf("Error computing pod progress fingerprint for ModelServing %s: %v", name, fpErr)
+		} else if fingerprint != lastFingerprint {
+			lastFingerprint = fingerprint
+			progressed = true
+		}
+
+		if progressed {
+			lastProgress = now
 			extended := now.Add(2 * time.Minute)
-			newDeadline := extended
-			if initialDeadline.After(extended) {
-				newDeadline = initialDeadline
-			}
-			if newDeadline.After(deadline) {
-				deadline = newDeadline
+			if extended.After(deadline) {
+				deadline = extended
 				if deadline.After(hardDeadline) {
 					deadline = hardDeadline
 				}
-				t.Logf("Progress: %d/%d replicas ready, deadline extended to %v", lastAvailable, expectedReplicas, deadline.Sub(start))
+				cfg.logf("Progress: %d/%d replicas ready, deadline extended to %v", lastAvailable, expectedReplicas, deadline.Sub(start))
 			}
 		}
 
+		if cfg.stallTimeout > 0 && now.Sub(lastProgress) >= cfg.stallTimeout {
+			cfg.logf("No progress for %v, dumping diagnostics before failing fast", cfg.stallTimeout)
+			dumpStallDiagnostics(ctx, cfg.logf, kubeClient, namespace, name, cfg.eventTail)
+			return false, fmt.Errorf("stalled: no progress for %v (last reason: %s)", cfg.stallTimeout, reason)
+		}
+
 		return false, nil
 	})
-	require.NoError(t, err, "ModelServing did not become ready")
 }
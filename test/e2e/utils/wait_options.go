@@ -0,0 +1,59 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import "time"
+
+// waitConfig holds the tunables shared by the ModelServing waiters.
+type waitConfig struct {
+	initialDeadline time.Duration
+	hardDeadline    time.Duration
+	pollInterval    time.Duration
+	stallTimeout    time.Duration
+	eventTail       int
+	logf            func(format string, args ...interface{})
+}
+
+// WaitOption customizes the behavior of WaitForModelServingReady and
+// WaitForModelServingReadyWithProgressExtend.
+type WaitOption func(*waitConfig)
+
+// WithStallTimeout overrides how long the waiter will tolerate no progress
+// (neither an AvailableReplicas increase nor a change in per-pod container
+// state) before dumping diagnostics and failing fast.
+// A value of 0 disables stall detection.
+func WithStallTimeout(d time.Duration) WaitOption {
+	return func(c *waitConfig) { c.stallTimeout = d }
+}
+
+// WithHardDeadline overrides the absolute upper bound the deadline may be
+// extended to.
+func WithHardDeadline(d time.Duration) WaitOption {
+	return func(c *waitConfig) { c.hardDeadline = d }
+}
+
+// WithEventTail overrides how many trailing Warning events per pod are
+// dumped when a stall is detected.
+func WithEventTail(n int) WaitOption {
+	return func(c *waitConfig) { c.eventTail = n }
+}
+
+// WithLogger overrides where progress and diagnostic output is written.
+// Defaults to t.Logf.
+func WithLogger(logf func(format string, args ...interface{})) WaitOption {
+	return func(c *waitConfig) { c.logf = logf }
+}